@@ -0,0 +1,13 @@
+package wasm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	juno "github.com/forbole/juno/v3/types"
+)
+
+// StoreCodeAndInstantiateHandler is an optional hook that a node-specific build (e.g. one built
+// against a Finschia/LBM-SDK chain) can set from its own init() to teach the wasm module how to
+// handle the combined MsgStoreCodeAndInstantiateContract message exposed by that fork. It returns
+// whether msg was handled, and if so the error (if any) resulting from handling it. Vanilla wasmd
+// chains never register this hook, so HandleMsg simply falls through for them.
+var StoreCodeAndInstantiateHandler func(m *Module, index int, tx *juno.Tx, msg sdk.Msg) (handled bool, err error)
@@ -0,0 +1,94 @@
+package wasm
+
+import "strings"
+
+// EventsIndexingConfig configures which contract-emitted wasm / wasm-* events get persisted into
+// the wasm_contract_event table. Leaving every list empty indexes all of them, which is fine for
+// low-throughput chains but can make the table grow unbounded on busy ones.
+type EventsIndexingConfig struct {
+	IncludeContracts  []string `yaml:"include_contracts"`
+	ExcludeContracts  []string `yaml:"exclude_contracts"`
+	IncludeEventTypes []string `yaml:"include_event_types"`
+	ExcludeEventTypes []string `yaml:"exclude_event_types"`
+}
+
+// NewEventsIndexingConfig allows to build a new EventsIndexingConfig instance
+func NewEventsIndexingConfig(includeContracts, excludeContracts, includeEventTypes, excludeEventTypes []string) EventsIndexingConfig {
+	return EventsIndexingConfig{
+		IncludeContracts:  includeContracts,
+		ExcludeContracts:  excludeContracts,
+		IncludeEventTypes: includeEventTypes,
+		ExcludeEventTypes: excludeEventTypes,
+	}
+}
+
+// ShouldIndex tells whether the event having the given type and emitted by the given contract
+// address should be persisted, based on the configured include/exclude lists. Exclude lists take
+// precedence over include lists, and an empty include list means "include everything".
+func (cfg EventsIndexingConfig) ShouldIndex(contractAddress string, eventType string) bool {
+	if containsAny(cfg.ExcludeContracts, contractAddress) || containsAny(cfg.ExcludeEventTypes, eventType) {
+		return false
+	}
+
+	if len(cfg.IncludeContracts) > 0 && !containsAny(cfg.IncludeContracts, contractAddress) {
+		return false
+	}
+
+	if len(cfg.IncludeEventTypes) > 0 && !containsAny(cfg.IncludeEventTypes, eventType) {
+		return false
+	}
+
+	return true
+}
+
+// ContractStateSnapshotEntry describes a single smart contract query to run periodically and
+// persist as a new point of a (contract_address, label) time series
+type ContractStateSnapshotEntry struct {
+	ContractAddress string `yaml:"contract_address"`
+	QueryMsg        string `yaml:"query_msg"`
+	Label           string `yaml:"label"`
+}
+
+// NewContractStateSnapshotEntry allows to build a new ContractStateSnapshotEntry instance
+func NewContractStateSnapshotEntry(contractAddress string, queryMsg string, label string) ContractStateSnapshotEntry {
+	return ContractStateSnapshotEntry{
+		ContractAddress: contractAddress,
+		QueryMsg:        queryMsg,
+		Label:           label,
+	}
+}
+
+// ContractStateSnapshotConfig configures the periodic contract state snapshot operation.
+// QueriesPerSecond caps how fast Entries are queried against the node, so a long list of entries
+// doesn't stall the rest of the indexer; 0 means unlimited.
+type ContractStateSnapshotConfig struct {
+	Entries          []ContractStateSnapshotEntry `yaml:"entries"`
+	QueriesPerSecond float64                      `yaml:"queries_per_second"`
+}
+
+// NewContractStateSnapshotConfig allows to build a new ContractStateSnapshotConfig instance
+func NewContractStateSnapshotConfig(entries []ContractStateSnapshotEntry, queriesPerSecond float64) ContractStateSnapshotConfig {
+	return ContractStateSnapshotConfig{
+		Entries:          entries,
+		QueriesPerSecond: queriesPerSecond,
+	}
+}
+
+// containsAny tells whether value matches any of the patterns, where a trailing "*" is treated as
+// a prefix match (e.g. "wasm-*" matches the "wasm-transfer" and "wasm-mint" event types)
+func containsAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(value, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+			continue
+		}
+
+		if pattern == value {
+			return true
+		}
+	}
+
+	return false
+}
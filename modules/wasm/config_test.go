@@ -0,0 +1,120 @@
+package wasm
+
+import "testing"
+
+func TestContainsAny(t *testing.T) {
+	testCases := []struct {
+		name     string
+		patterns []string
+		value    string
+		expected bool
+	}{
+		{
+			name:     "empty patterns never match",
+			patterns: []string{},
+			value:    "wasm-transfer",
+			expected: false,
+		},
+		{
+			name:     "exact match",
+			patterns: []string{"wasm-transfer"},
+			value:    "wasm-transfer",
+			expected: true,
+		},
+		{
+			name:     "exact pattern does not match a different value",
+			patterns: []string{"wasm-transfer"},
+			value:    "wasm-mint",
+			expected: false,
+		},
+		{
+			name:     "trailing star matches as a prefix",
+			patterns: []string{"wasm-*"},
+			value:    "wasm-mint",
+			expected: true,
+		},
+		{
+			name:     "trailing star does not match unrelated value",
+			patterns: []string{"wasm-*"},
+			value:    "transfer",
+			expected: false,
+		},
+		{
+			name:     "matches if any pattern matches",
+			patterns: []string{"foo", "wasm-*"},
+			value:    "wasm-burn",
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := containsAny(tc.patterns, tc.value); actual != tc.expected {
+				t.Errorf("containsAny(%v, %q) = %v, expected %v", tc.patterns, tc.value, actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestEventsIndexingConfig_ShouldIndex(t *testing.T) {
+	testCases := []struct {
+		name            string
+		cfg             EventsIndexingConfig
+		contractAddress string
+		eventType       string
+		expected        bool
+	}{
+		{
+			name:            "no filters configured indexes everything",
+			cfg:             NewEventsIndexingConfig(nil, nil, nil, nil),
+			contractAddress: "contract1",
+			eventType:       "wasm-transfer",
+			expected:        true,
+		},
+		{
+			name:            "excluded contract is never indexed",
+			cfg:             NewEventsIndexingConfig(nil, []string{"contract1"}, nil, nil),
+			contractAddress: "contract1",
+			eventType:       "wasm-transfer",
+			expected:        false,
+		},
+		{
+			name:            "excluded event type is never indexed",
+			cfg:             NewEventsIndexingConfig(nil, nil, nil, []string{"wasm-transfer"}),
+			contractAddress: "contract1",
+			eventType:       "wasm-transfer",
+			expected:        false,
+		},
+		{
+			name:            "exclude takes precedence over include",
+			cfg:             NewEventsIndexingConfig([]string{"contract1"}, []string{"contract1"}, nil, nil),
+			contractAddress: "contract1",
+			eventType:       "wasm-transfer",
+			expected:        false,
+		},
+		{
+			name:            "include list restricts to the listed contracts",
+			cfg:             NewEventsIndexingConfig([]string{"contract1"}, nil, nil, nil),
+			contractAddress: "contract2",
+			eventType:       "wasm-transfer",
+			expected:        false,
+		},
+		{
+			name:            "include list restricts to the listed event types",
+			cfg:             NewEventsIndexingConfig(nil, nil, []string{"wasm-mint"}, nil),
+			contractAddress: "contract1",
+			eventType:       "wasm-transfer",
+			expected:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.cfg.ShouldIndex(tc.contractAddress, tc.eventType); actual != tc.expected {
+				t.Errorf("ShouldIndex(%q, %q) = %v, expected %v", tc.contractAddress, tc.eventType, actual, tc.expected)
+			}
+		})
+	}
+}
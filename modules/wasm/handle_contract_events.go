@@ -0,0 +1,58 @@
+package wasm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/forbole/bdjuno/v3/types"
+	juno "github.com/forbole/juno/v3/types"
+)
+
+const (
+	wasmEventType                   = "wasm"
+	wasmEventTypePrefix             = "wasm-"
+	wasmEventAttrKeyContractAddress = "_contract_address"
+)
+
+// HandleContractEvents walks every event logged while executing the message at the given index --
+// a message that targets a CosmWasm contract (Instantiate, Execute, Migrate, Sudo) -- and persists
+// the ones emitted by the contract itself -- events of type "wasm" or prefixed "wasm-", such as
+// the "wasm-transfer" and "wasm-mint" events commonly emitted by CW20/CW721 contracts -- into the
+// wasm_contract_event table. It is shared by every HandleMsg* handler that can trigger
+// contract-emitted events. It is scoped to tx.Logs[index] rather than the whole tx, since a tx can
+// bundle more than one such message (e.g. a router tx issuing several MsgExecuteContracts) and
+// walking the whole tx from each of them would re-save the same rows once per qualifying message.
+func (m *Module) HandleContractEvents(tx *juno.Tx, index int) error {
+	for eventIndex, event := range tx.Logs[index].Events {
+		if event.Type != wasmEventType && !strings.HasPrefix(event.Type, wasmEventTypePrefix) {
+			continue
+		}
+
+		attributes := make(map[string]string, len(event.Attributes))
+		var contractAddress string
+		for _, attribute := range event.Attributes {
+			if attribute.Key == wasmEventAttrKeyContractAddress {
+				contractAddress = attribute.Value
+				continue
+			}
+			attributes[attribute.Key] = attribute.Value
+		}
+
+		if contractAddress == "" {
+			continue
+		}
+
+		if !m.eventsIndexingConfig.ShouldIndex(contractAddress, event.Type) {
+			continue
+		}
+
+		err := m.db.SaveWasmContractEvent(
+			types.NewWasmContractEvent(tx.Height, tx.TxHash, index, eventIndex, contractAddress, event.Type, attributes),
+		)
+		if err != nil {
+			return fmt.Errorf("error while saving WasmContractEvent: %s", err)
+		}
+	}
+
+	return nil
+}
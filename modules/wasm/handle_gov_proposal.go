@@ -0,0 +1,215 @@
+package wasm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/forbole/bdjuno/v3/types"
+	"github.com/rs/zerolog/log"
+)
+
+// GovProposalContentHandler is implemented by any module that wants to react to a gov proposal's
+// content once the proposal carrying it has passed and been executed. The gov module keeps a list
+// of registered handlers and, for every proposal it finalizes in its EndBlocker, calls Handle on
+// each of them with that proposal's content, its execution height/time, and the events the
+// EndBlocker execution produced; a handler that doesn't recognize the content type is expected to
+// return nil rather than an error. The wasm module registers itself as one of these handlers from
+// the app's module wiring (app.go), alongside the other gov-aware modules (e.g. upgrade, params).
+type GovProposalContentHandler interface {
+	HandleGovProposalContent(proposalID uint64, height int64, blockTime time.Time, events sdk.StringEvents, content govtypes.Content) error
+}
+
+var _ GovProposalContentHandler = &Module{}
+
+// HandleGovProposalContent implements GovProposalContentHandler. It threads the resulting CodeID /
+// contract address / admin / pinned code set of an executed wasm gov proposal back into the
+// existing wasm_code and wasm_contract tables.
+func (m *Module) HandleGovProposalContent(proposalID uint64, height int64, blockTime time.Time, events sdk.StringEvents, content govtypes.Content) error {
+	switch wasmProposal := content.(type) {
+	case *wasmtypes.StoreCodeProposal:
+		return m.handleStoreCodeProposal(proposalID, height, events, wasmProposal)
+	case *wasmtypes.InstantiateContractProposal:
+		return m.handleInstantiateContractProposal(proposalID, height, blockTime, wasmProposal)
+	case *wasmtypes.MigrateContractProposal:
+		return m.handleMigrateContractProposal(proposalID, height, wasmProposal)
+	case *wasmtypes.ExecuteContractProposal:
+		return m.handleExecuteContractProposal(proposalID, height, wasmProposal)
+	case *wasmtypes.SudoContractProposal:
+		return m.handleSudoContractProposal(proposalID, height, wasmProposal)
+	case *wasmtypes.UpdateAdminProposal:
+		return m.handleUpdateAdminProposal(proposalID, height, wasmProposal)
+	case *wasmtypes.ClearAdminProposal:
+		return m.handleClearAdminProposal(proposalID, height, wasmProposal)
+	case *wasmtypes.PinCodesProposal:
+		return m.handlePinCodesProposal(proposalID, height, wasmProposal)
+	case *wasmtypes.UnpinCodesProposal:
+		return m.handleUnpinCodesProposal(proposalID, height, wasmProposal)
+	}
+
+	return nil
+}
+
+func (m *Module) handleStoreCodeProposal(proposalID uint64, height int64, events sdk.StringEvents, proposal *wasmtypes.StoreCodeProposal) error {
+	err := m.db.SaveWasmStoreCodeProposal(
+		types.NewWasmStoreCodeProposal(proposalID, proposal.RunAs, proposal.WASMByteCode, proposal.InstantiatePermission, height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmStoreCodeProposal: %s", err)
+	}
+
+	codeID, err := m.source.GetLastCodeID(height)
+	if err != nil {
+		return fmt.Errorf("error while getting code id stored by proposal %d: %s", proposalID, err)
+	}
+
+	uncompressedWasmByteCode, computedChecksum, err := decompressWasmByteCode(proposal.WASMByteCode)
+	if err != nil {
+		return fmt.Errorf("error while decompressing wasm byte code: %s", err)
+	}
+
+	// Compare against the code_checksum emitted by the store_code event resulting from this
+	// proposal's execution, just like we do for a user-sent MsgStoreCode. Fall back to the
+	// locally-computed checksum (and leave checksum_ok true) only if the chain didn't emit one.
+	checksum := computedChecksum[:]
+	checksumOK := true
+	if checksumHex, found := findEventAttribute(events, wasmtypes.EventTypeStoreCode, attributeKeyCodeChecksum); found {
+		expectedChecksum, err := hex.DecodeString(checksumHex)
+		if err != nil {
+			return fmt.Errorf("error while decoding code checksum: %s", err)
+		}
+
+		checksum = expectedChecksum
+		checksumOK = bytes.Equal(computedChecksum[:], expectedChecksum)
+		if !checksumOK {
+			log.Error().Str("module", "wasm").Uint64("proposal_id", proposalID).Int64("height", height).
+				Msg("code checksum mismatch, persisting anyway with checksum_ok=false")
+		}
+	}
+
+	err = m.db.SaveWasmCodeBytes(types.NewWasmCodeBytes(checksum, uncompressedWasmByteCode))
+	if err != nil {
+		return fmt.Errorf("error while saving WasmCodeBytes: %s", err)
+	}
+
+	return m.db.SaveWasmCode(
+		types.NewWasmCode(
+			proposal.RunAs, proposal.WASMByteCode, proposal.InstantiatePermission, codeID, "gov",
+			checksum, len(proposal.WASMByteCode), len(uncompressedWasmByteCode), checksumOK, height,
+		),
+	)
+}
+
+// findEventAttribute looks up the value of attrKey within the events of type eventType found in
+// events, returning it from the first such event that carries that key. It plays the role of
+// juno.Tx's FindEventByType/FindAttributeByKey for the raw block-level sdk.StringEvents produced by
+// a proposal's EndBlocker execution (as opposed to a tx's logs)
+func findEventAttribute(events sdk.StringEvents, eventType string, attrKey string) (string, bool) {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		if value, ok := attributesOf(event)[attrKey]; ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+func (m *Module) handleInstantiateContractProposal(proposalID uint64, height int64, blockTime time.Time, proposal *wasmtypes.InstantiateContractProposal) error {
+	err := m.db.SaveWasmInstantiateContractProposal(
+		types.NewWasmInstantiateContractProposal(proposalID, proposal.RunAs, proposal.Admin, proposal.CodeID, proposal.Label, proposal.Msg, proposal.Funds.String(), height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmInstantiateContractProposal: %s", err)
+	}
+
+	contractAddress, err := m.source.GetLastInstantiatedContractAddress(height, proposal.CodeID)
+	if err != nil {
+		return fmt.Errorf("error while getting contract address instantiated by proposal %d: %s", proposalID, err)
+	}
+
+	contractInfo, err := m.source.GetContractInfo(height, contractAddress)
+	if err != nil {
+		return fmt.Errorf("error while getting contract info for %s: %s", contractAddress, err)
+	}
+
+	return m.db.SaveWasmContract(
+		types.NewWasmContract(proposal.RunAs, proposal.Admin, proposal.CodeID, proposal.Label, proposal.Msg, proposal.Funds, contractAddress, "",
+			blockTime, contractInfo.Creator, contractInfo.Extension, "gov", height,
+		),
+	)
+}
+
+func (m *Module) handleMigrateContractProposal(proposalID uint64, height int64, proposal *wasmtypes.MigrateContractProposal) error {
+	err := m.db.SaveWasmMigrateContractProposal(
+		types.NewWasmMigrateContractProposal(proposalID, proposal.Contract, proposal.CodeID, proposal.Msg, height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmMigrateContractProposal: %s", err)
+	}
+
+	return m.db.UpdateContractWithMsgMigrateContract(govtypes.ModuleName, proposal.Contract, proposal.CodeID, proposal.Msg, "")
+}
+
+func (m *Module) handleExecuteContractProposal(proposalID uint64, height int64, proposal *wasmtypes.ExecuteContractProposal) error {
+	return m.db.SaveWasmExecuteContractProposal(
+		types.NewWasmExecuteContractProposal(proposalID, proposal.RunAs, proposal.Contract, proposal.Msg, proposal.Funds.String(), height),
+	)
+}
+
+func (m *Module) handleSudoContractProposal(proposalID uint64, height int64, proposal *wasmtypes.SudoContractProposal) error {
+	return m.db.SaveWasmSudoContractProposal(
+		types.NewWasmSudoContractProposal(proposalID, proposal.Contract, proposal.Msg, height),
+	)
+}
+
+func (m *Module) handleUpdateAdminProposal(proposalID uint64, height int64, proposal *wasmtypes.UpdateAdminProposal) error {
+	err := m.db.SaveWasmUpdateAdminProposal(
+		types.NewWasmUpdateAdminProposal(proposalID, proposal.NewAdmin, proposal.Contract, height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmUpdateAdminProposal: %s", err)
+	}
+
+	return m.db.UpdateContractAdmin(govtypes.ModuleName, proposal.Contract, proposal.NewAdmin)
+}
+
+func (m *Module) handleClearAdminProposal(proposalID uint64, height int64, proposal *wasmtypes.ClearAdminProposal) error {
+	err := m.db.SaveWasmClearAdminProposal(
+		types.NewWasmClearAdminProposal(proposalID, proposal.Contract, height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmClearAdminProposal: %s", err)
+	}
+
+	return m.db.UpdateContractAdmin(govtypes.ModuleName, proposal.Contract, "")
+}
+
+func (m *Module) handlePinCodesProposal(proposalID uint64, height int64, proposal *wasmtypes.PinCodesProposal) error {
+	err := m.db.SaveWasmPinCodesProposal(
+		types.NewWasmPinCodesProposal(proposalID, proposal.CodeIDs, height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmPinCodesProposal: %s", err)
+	}
+
+	return m.db.UpdateWasmCodesPinned(proposal.CodeIDs, true)
+}
+
+func (m *Module) handleUnpinCodesProposal(proposalID uint64, height int64, proposal *wasmtypes.UnpinCodesProposal) error {
+	err := m.db.SaveWasmUnpinCodesProposal(
+		types.NewWasmUnpinCodesProposal(proposalID, proposal.CodeIDs, height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmUnpinCodesProposal: %s", err)
+	}
+
+	return m.db.UpdateWasmCodesPinned(proposal.CodeIDs, false)
+}
@@ -0,0 +1,109 @@
+package wasm
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func TestFindEventAttribute(t *testing.T) {
+	events := sdk.StringEvents{
+		{
+			Type: "store_code",
+			Attributes: []sdk.Attribute{
+				{Key: "code_id", Value: "1"},
+				{Key: "code_checksum", Value: "abcd"},
+			},
+		},
+		{
+			Type: "instantiate",
+			Attributes: []sdk.Attribute{
+				{Key: "_contract_address", Value: "wasm1contract"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		eventType     string
+		attrKey       string
+		expectedValue string
+		expectedFound bool
+	}{
+		{
+			name:          "finds the attribute on the matching event",
+			eventType:     "store_code",
+			attrKey:       "code_checksum",
+			expectedValue: "abcd",
+			expectedFound: true,
+		},
+		{
+			name:          "event type matches but attribute key does not",
+			eventType:     "store_code",
+			attrKey:       "code_checksum_missing",
+			expectedValue: "",
+			expectedFound: false,
+		},
+		{
+			name:          "event type is not present at all",
+			eventType:     "migrate_contract",
+			attrKey:       "code_checksum",
+			expectedValue: "",
+			expectedFound: false,
+		},
+		{
+			name:          "attribute key only matches under a different event type",
+			eventType:     "instantiate",
+			attrKey:       "code_checksum",
+			expectedValue: "",
+			expectedFound: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			value, found := findEventAttribute(events, tc.eventType, tc.attrKey)
+			if found != tc.expectedFound {
+				t.Fatalf("findEventAttribute(%q, %q) found = %v, expected %v", tc.eventType, tc.attrKey, found, tc.expectedFound)
+			}
+			if value != tc.expectedValue {
+				t.Errorf("findEventAttribute(%q, %q) value = %q, expected %q", tc.eventType, tc.attrKey, value, tc.expectedValue)
+			}
+		})
+	}
+}
+
+// TestHandleGovProposalContent_UnrecognizedContent asserts that the type switch in
+// HandleGovProposalContent is a no-op (and, crucially, never touches m.db/m.source) for any gov
+// proposal content the wasm module doesn't own, regardless of which other module registered it.
+func TestHandleGovProposalContent_UnrecognizedContent(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content govtypes.Content
+	}{
+		{
+			name:    "a plain text proposal",
+			content: &govtypes.TextProposal{Title: "not a wasm proposal", Description: "n/a"},
+		},
+		{
+			name:    "a params module proposal",
+			content: &paramproposal.ParameterChangeProposal{Title: "change a param", Description: "n/a"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			m := &Module{}
+
+			err := m.HandleGovProposalContent(1, 1, time.Now(), sdk.StringEvents{}, tc.content)
+			if err != nil {
+				t.Fatalf("HandleGovProposalContent() error = %s, expected nil for unrecognized content", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package wasm
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+	"github.com/forbole/bdjuno/v3/types"
+	juno "github.com/forbole/juno/v3/types"
+	tmctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+// wasmPortPrefix is the prefix every IBC port owned by a CosmWasm contract carries, as derived by
+// wasmd: a contract's port is always "wasm.<contract_address>"
+const wasmPortPrefix = "wasm."
+
+// GetIBCContractPort returns every CosmWasm contract address we've seen open an IBC channel so
+// far, together with the port it owns, based on the wasm_contract_ibc_channel rows indexed by
+// HandleIBCEvents. This is what lets an operator discover which contracts are IBC-enabled without
+// already knowing their address.
+func (m *Module) GetIBCContractPort() ([]types.WasmContractIBCChannel, error) {
+	return m.db.GetWasmContractIBCChannels()
+}
+
+// contractAddressFromPort returns the contract address owning portID, and whether portID belongs
+// to a CosmWasm contract at all
+func contractAddressFromPort(portID string) (string, bool) {
+	if !strings.HasPrefix(portID, wasmPortPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(portID, wasmPortPrefix), true
+}
+
+// HandleIBCEvents walks every event found in tx and persists the ones related to an IBC channel
+// or packet owned by a CosmWasm contract port into the wasm_contract_ibc_channel and
+// wasm_contract_ibc_packet tables
+func (m *Module) HandleIBCEvents(tx *juno.Tx) error {
+	for _, log := range tx.Logs {
+		for _, event := range log.Events {
+			if err := m.handleIBCEvent(tx.Height, tx.TxHash, event); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// HandleBlock implements modules.BlockModule and is the single place IBC events are indexed from.
+// Packets relayed through a tx that doesn't otherwise target the wasm module -- such as a
+// relayer-submitted MsgRecvPacket, MsgAcknowledgement or MsgTimeout -- never reach HandleMsg, so
+// scanning every tx of the block here is the only way to catch those. HandleMsg intentionally does
+// not also call HandleIBCEvents, since every one of its txs is covered by this sweep too and
+// calling it from both places would double-insert rows.
+func (m *Module) HandleBlock(block *tmctypes.ResultBlock, _ *tmctypes.ResultBlockResults, txs []*juno.Tx, _ *tmctypes.ResultValidators) error {
+	for _, tx := range txs {
+		if err := m.HandleIBCEvents(tx); err != nil {
+			return fmt.Errorf("error while handling wasm IBC events for block %d: %s", block.Block.Height, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *Module) handleIBCEvent(height int64, txHash string, event sdk.StringEvent) error {
+	switch event.Type {
+	case channeltypes.EventTypeSendPacket, channeltypes.EventTypeRecvPacket,
+		channeltypes.EventTypeAcknowledgePacket, channeltypes.EventTypeTimeoutPacket:
+		return m.handleIBCPacketEvent(height, txHash, event)
+	case channeltypes.EventTypeWriteAck:
+		return m.handleIBCPacketAckEvent(height, event)
+	case channeltypes.EventTypeChannelOpenInit, channeltypes.EventTypeChannelOpenTry,
+		channeltypes.EventTypeChannelOpenAck, channeltypes.EventTypeChannelOpenConfirm,
+		channeltypes.EventTypeChannelClose, channeltypes.EventTypeChannelCloseConfirm:
+		return m.handleIBCChannelEvent(height, event)
+	}
+
+	return nil
+}
+
+func (m *Module) handleIBCPacketEvent(height int64, txHash string, event sdk.StringEvent) error {
+	attrs := attributesOf(event)
+
+	// A send_packet fires on the sending chain and is owned by its source port/channel. A
+	// recv_packet fires on the receiving chain and is owned by its destination port/channel. An
+	// acknowledge_packet/timeout_packet, however, both fire back on the *sending* chain once the
+	// packet is acked or expires, so they're owned by the source port/channel too. None of these
+	// four events carry the ack payload itself -- that only shows up in a write_acknowledgement
+	// event, handled separately by handleIBCPacketAckEvent, which fills in the ack of the row a
+	// recv_packet inserted once it arrives; send/acknowledge/timeout rows keep a nil ack.
+	direction := "send"
+	portID := attrs[channeltypes.AttributeKeySrcPort]
+	channelID := attrs[channeltypes.AttributeKeySrcChannel]
+	if event.Type == channeltypes.EventTypeRecvPacket {
+		direction = "recv"
+		portID = attrs[channeltypes.AttributeKeyDstPort]
+		channelID = attrs[channeltypes.AttributeKeyDstChannel]
+	}
+
+	contractAddress, ok := contractAddressFromPort(portID)
+	if !ok {
+		return nil
+	}
+
+	sequence, err := strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64)
+	if err != nil {
+		return fmt.Errorf("error while parsing packet sequence: %s", err)
+	}
+
+	return m.db.SaveWasmContractIBCPacket(
+		types.NewWasmContractIBCPacket(
+			contractAddress, channelID, portID, sequence, direction,
+			[]byte(attrs[channeltypes.AttributeKeyData]), nil,
+			attrs[channeltypes.AttributeKeyTimeoutHeight], height, txHash,
+		),
+	)
+}
+
+// handleIBCPacketAckEvent handles a write_acknowledgement event, the only IBC event that actually
+// carries a packet's ack payload. It fires on the destination chain immediately after recv_packet,
+// under that same destination port/channel, so it updates the wasm_contract_ibc_packet row
+// recv_packet already inserted rather than creating a new one.
+func (m *Module) handleIBCPacketAckEvent(height int64, event sdk.StringEvent) error {
+	attrs := attributesOf(event)
+
+	portID := attrs[channeltypes.AttributeKeyDstPort]
+	channelID := attrs[channeltypes.AttributeKeyDstChannel]
+
+	contractAddress, ok := contractAddressFromPort(portID)
+	if !ok {
+		return nil
+	}
+
+	sequence, err := strconv.ParseUint(attrs[channeltypes.AttributeKeySequence], 10, 64)
+	if err != nil {
+		return fmt.Errorf("error while parsing packet sequence: %s", err)
+	}
+
+	ack, err := packetAckBytes(attrs)
+	if err != nil {
+		return fmt.Errorf("error while decoding packet ack: %s", err)
+	}
+
+	return m.db.UpdateWasmContractIBCPacketAck(contractAddress, channelID, portID, sequence, ack)
+}
+
+// packetAckBytes extracts a write_acknowledgement event's ack payload, preferring the binary-safe
+// packet_ack_hex attribute (added in ibc-go v4) and falling back to the older plain-string
+// packet_ack for chains still on an earlier ibc-go version.
+func packetAckBytes(attrs map[string]string) ([]byte, error) {
+	if ackHex, ok := attrs[channeltypes.AttributeKeyAckHex]; ok {
+		return hex.DecodeString(ackHex)
+	}
+	return []byte(attrs[channeltypes.AttributeKeyAck]), nil
+}
+
+func (m *Module) handleIBCChannelEvent(height int64, event sdk.StringEvent) error {
+	attrs := attributesOf(event)
+
+	portID := attrs[channeltypes.AttributeKeyPortID]
+	contractAddress, ok := contractAddressFromPort(portID)
+	if !ok {
+		return nil
+	}
+
+	state := strings.TrimPrefix(event.Type, "channel_")
+
+	return m.db.SaveWasmContractIBCChannel(
+		types.NewWasmContractIBCChannel(
+			contractAddress, portID, attrs[channeltypes.AttributeKeyChannelID], attrs[channeltypes.AttributeKeyConnectionID],
+			attrs[channeltypes.AttributeCounterpartyPortID], attrs[channeltypes.AttributeCounterpartyChannelID], state, height,
+		),
+	)
+}
+
+// attributesOf flattens a StringEvent's attributes into a map for convenient lookup
+func attributesOf(event sdk.StringEvent) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attribute := range event.Attributes {
+		attrs[attribute.Key] = attribute.Value
+	}
+	return attrs
+}
@@ -0,0 +1,100 @@
+package wasm
+
+import (
+	"encoding/hex"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+)
+
+func TestContractAddressFromPort(t *testing.T) {
+	testCases := []struct {
+		name            string
+		portID          string
+		expectedAddress string
+		expectedOK      bool
+	}{
+		{
+			name:            "wasm port yields the contract address",
+			portID:          "wasm.wasm14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr",
+			expectedAddress: "wasm14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr",
+			expectedOK:      true,
+		},
+		{
+			name:            "transfer port is not a wasm port",
+			portID:          "transfer",
+			expectedAddress: "",
+			expectedOK:      false,
+		},
+		{
+			name:            "empty port id is not a wasm port",
+			portID:          "",
+			expectedAddress: "",
+			expectedOK:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			address, ok := contractAddressFromPort(tc.portID)
+			if ok != tc.expectedOK {
+				t.Fatalf("contractAddressFromPort(%q) ok = %v, expected %v", tc.portID, ok, tc.expectedOK)
+			}
+			if address != tc.expectedAddress {
+				t.Errorf("contractAddressFromPort(%q) address = %q, expected %q", tc.portID, address, tc.expectedAddress)
+			}
+		})
+	}
+}
+
+func TestPacketAckBytes(t *testing.T) {
+	ack := []byte(`{"result":"AQ=="}`)
+
+	testCases := []struct {
+		name        string
+		attrs       map[string]string
+		expectedAck []byte
+		expectErr   bool
+	}{
+		{
+			name: "prefers the binary-safe packet_ack_hex attribute when present",
+			attrs: map[string]string{
+				channeltypes.AttributeKeyAckHex: hex.EncodeToString(ack),
+				channeltypes.AttributeKeyAck:    "ignored in favor of the hex attribute",
+			},
+			expectedAck: ack,
+		},
+		{
+			name: "falls back to the plain-string packet_ack attribute",
+			attrs: map[string]string{
+				channeltypes.AttributeKeyAck: string(ack),
+			},
+			expectedAck: ack,
+		},
+		{
+			name:      "invalid packet_ack_hex is an error",
+			attrs:     map[string]string{channeltypes.AttributeKeyAckHex: "not-hex"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := packetAckBytes(tc.attrs)
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("packetAckBytes(%v) error = nil, expected an error", tc.attrs)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("packetAckBytes(%v) unexpected error: %s", tc.attrs, err)
+			}
+			if string(actual) != string(tc.expectedAck) {
+				t.Errorf("packetAckBytes(%v) = %q, expected %q", tc.attrs, actual, tc.expectedAck)
+			}
+		})
+	}
+}
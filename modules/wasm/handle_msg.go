@@ -1,7 +1,9 @@
 package wasm
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"time"
@@ -10,8 +12,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/forbole/bdjuno/v3/types"
 	juno "github.com/forbole/juno/v3/types"
+	"github.com/rs/zerolog/log"
 )
 
+// attributeKeyCodeChecksum is the sha256 checksum of the decompressed wasm module, emitted by the
+// chain as part of the store_code event. It isn't (yet) exported as a constant by every wasmtypes
+// version, hence the local copy.
+const attributeKeyCodeChecksum = "code_checksum"
+
 // HandleMsg implements modules.MessageModule
 func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
 	if len(tx.Logs) == 0 {
@@ -31,6 +39,18 @@ func (m *Module) HandleMsg(index int, msg sdk.Msg, tx *juno.Tx) error {
 		return m.HandleMsgUpdateAdmin(cosmosMsg)
 	case *wasmtypes.MsgClearAdmin:
 		return m.HandleMsgClearAdmin(cosmosMsg)
+	case *wasmtypes.MsgSudoContract:
+		return m.HandleMsgSudoContract(index, tx, cosmosMsg)
+	default:
+		// Some Cosmos-SDK forks (e.g. Finschia/LBM-SDK) define extra wasm messages that don't
+		// exist in vanilla wasmd. Node-specific builds can register a hook to handle those here
+		// without this package depending on their types directly.
+		if StoreCodeAndInstantiateHandler != nil {
+			handled, err := StoreCodeAndInstantiateHandler(m, index, tx, msg)
+			if handled {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -56,8 +76,40 @@ func (m *Module) HandleMsgStoreCode(index int, tx *juno.Tx, msg *wasmtypes.MsgSt
 		return fmt.Errorf("error while parsing code id to int64: %s", err)
 	}
 
+	uncompressedWasmByteCode, computedChecksum, err := decompressWasmByteCode(msg.WASMByteCode)
+	if err != nil {
+		return fmt.Errorf("error while decompressing wasm byte code: %s", err)
+	}
+
+	// Compare against the code_checksum emitted by the store code event. Not every chain/wasmd
+	// version emits this attribute, so a missing one isn't an error: fall back to the
+	// locally-computed checksum and leave checksum_ok true rather than failing the whole block.
+	checksum := computedChecksum[:]
+	checksumOK := true
+	if codeChecksumHex, err := tx.FindAttributeByKey(event, attributeKeyCodeChecksum); err == nil {
+		expectedChecksum, err := hex.DecodeString(codeChecksumHex)
+		if err != nil {
+			return fmt.Errorf("error while decoding code checksum: %s", err)
+		}
+
+		checksum = expectedChecksum
+		checksumOK = bytes.Equal(computedChecksum[:], expectedChecksum)
+		if !checksumOK {
+			log.Error().Str("module", "wasm").Int64("code_id", codeID).Int64("height", tx.Height).
+				Msg("code checksum mismatch, persisting anyway with checksum_ok=false")
+		}
+	}
+
+	err = m.db.SaveWasmCodeBytes(types.NewWasmCodeBytes(checksum, uncompressedWasmByteCode))
+	if err != nil {
+		return fmt.Errorf("error while saving WasmCodeBytes: %s", err)
+	}
+
 	return m.db.SaveWasmCode(
-		types.NewWasmCode(msg, codeID, tx.Height),
+		types.NewWasmCode(
+			msg.Sender, msg.WASMByteCode, msg.InstantiatePermission, uint64(codeID), "user",
+			checksum, len(msg.WASMByteCode), len(uncompressedWasmByteCode), checksumOK, tx.Height,
+		),
 	)
 }
 
@@ -97,11 +149,16 @@ func (m *Module) HandleMsgInstantiateContract(index int, tx *juno.Tx, msg *wasmt
 		return fmt.Errorf("error while parsing time: %s", err)
 	}
 
-	return m.db.SaveWasmContract(
-		types.NewWasmContract(msg, contractAddress, string(resultDataBz), timestamp,
-			contractInfo.Creator, contractInfo.Extension, tx.Height,
+	err = m.db.SaveWasmContract(
+		types.NewWasmContract(msg.Sender, msg.Admin, msg.CodeID, msg.Label, msg.Msg, msg.Funds, contractAddress, string(resultDataBz), timestamp,
+			contractInfo.Creator, contractInfo.Extension, "user", tx.Height,
 		),
 	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmContract: %s", err)
+	}
+
+	return m.HandleContractEvents(tx, index)
 }
 
 // HandleMsgExecuteContract allows to properly handle a MsgExecuteContract
@@ -128,9 +185,48 @@ func (m *Module) HandleMsgExecuteContract(index int, tx *juno.Tx, msg *wasmtypes
 		return fmt.Errorf("error while parsing time: %s", err)
 	}
 
-	return m.db.SaveWasmExecuteContract(
-		types.NewWasmExecuteContract(msg, string(resultDataBz), timestamp, tx.Height),
+	err = m.db.SaveWasmExecuteContract(
+		types.NewWasmExecuteContract(msg.Sender, msg.Contract, msg.Msg, msg.Funds, string(resultDataBz), timestamp, tx.Height),
+	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmExecuteContract: %s", err)
+	}
+
+	return m.HandleContractEvents(tx, index)
+}
+
+// HandleMsgSudoContract allows to properly handle a MsgSudoContract
+// Sudo Event invokes a privileged entry point on the contract that bypasses the usual permission checks
+func (m *Module) HandleMsgSudoContract(index int, tx *juno.Tx, msg *wasmtypes.MsgSudoContract) error {
+	// Get Sudo Contract event
+	event, err := tx.FindEventByType(index, wasmtypes.EventTypeSudo)
+	if err != nil {
+		return fmt.Errorf("error while searching for EventTypeSudo: %s", err)
+	}
+
+	// Get result data
+	resultData, err := tx.FindAttributeByKey(event, wasmtypes.AttributeKeyResultDataHex)
+	if err != nil {
+		return fmt.Errorf("error while searching for AttributeKeyResultDataHex: %s", err)
+	}
+	resultDataBz, err := base64.StdEncoding.DecodeString(resultData)
+	if err != nil {
+		return fmt.Errorf("error while decoding result data: %s", err)
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	err = m.db.SaveWasmSudoContract(
+		types.NewWasmSudoContract(msg.Sender, msg.Contract, msg.Msg, string(resultDataBz), timestamp, tx.Height),
 	)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmSudoContract: %s", err)
+	}
+
+	return m.HandleContractEvents(tx, index)
 }
 
 // HandleMsgMigrateContract allows to properly handle a MsgMigrateContract
@@ -152,7 +248,12 @@ func (m *Module) HandleMsgMigrateContract(index int, tx *juno.Tx, msg *wasmtypes
 		return fmt.Errorf("error while decoding result data: %s", err)
 	}
 
-	return m.db.UpdateContractWithMsgMigrateContract(msg.Sender, msg.Contract, msg.CodeID, msg.Msg, string(resultDataBz))
+	err = m.db.UpdateContractWithMsgMigrateContract(msg.Sender, msg.Contract, msg.CodeID, msg.Msg, string(resultDataBz))
+	if err != nil {
+		return fmt.Errorf("error while updating contract with MsgMigrateContract: %s", err)
+	}
+
+	return m.HandleContractEvents(tx, index)
 }
 
 // HandleMsgUpdateAdmin allows to properly handle a MsgUpdateAdmin
@@ -0,0 +1,130 @@
+//go:build finschia
+// +build finschia
+
+package wasm
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/forbole/bdjuno/v3/types"
+	juno "github.com/forbole/juno/v3/types"
+	lbmwasmtypes "github.com/line/lbm-sdk/x/wasm/types"
+	"github.com/rs/zerolog/log"
+)
+
+func init() {
+	StoreCodeAndInstantiateHandler = handleMsgStoreCodeAndInstantiateContract
+}
+
+func handleMsgStoreCodeAndInstantiateContract(m *Module, index int, tx *juno.Tx, msg sdk.Msg) (bool, error) {
+	cosmosMsg, ok := msg.(*lbmwasmtypes.MsgStoreCodeAndInstantiateContract)
+	if !ok {
+		return false, nil
+	}
+
+	return true, m.HandleMsgStoreCodeAndInstantiateContract(index, tx, cosmosMsg)
+}
+
+// HandleMsgStoreCodeAndInstantiateContract allows to properly handle a
+// MsgStoreCodeAndInstantiateContract, a combined message that stores a wasm blob and instantiates
+// it in the same tx, emitting both a store_code and an instantiate event. Both the resulting code
+// and contract are saved through db.SaveWasmCodeAndContract so that either both land or both roll
+// back.
+func (m *Module) HandleMsgStoreCodeAndInstantiateContract(index int, tx *juno.Tx, msg *lbmwasmtypes.MsgStoreCodeAndInstantiateContract) error {
+	// Get store code event
+	storeCodeEvent, err := tx.FindEventByType(index, wasmtypes.EventTypeStoreCode)
+	if err != nil {
+		return fmt.Errorf("error while searching for EventTypeStoreCode: %s", err)
+	}
+
+	codeIDKey, err := tx.FindAttributeByKey(storeCodeEvent, wasmtypes.AttributeKeyCodeID)
+	if err != nil {
+		return fmt.Errorf("error while searching for AttributeKeyCodeID: %s", err)
+	}
+
+	codeID, err := strconv.ParseInt(codeIDKey, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error while parsing code id to int64: %s", err)
+	}
+
+	uncompressedWasmByteCode, computedChecksum, err := decompressWasmByteCode(msg.WASMByteCode)
+	if err != nil {
+		return fmt.Errorf("error while decompressing wasm byte code: %s", err)
+	}
+
+	// Compare against the code_checksum emitted by the store code event. Not every chain/wasmd
+	// version emits this attribute, so a missing one isn't an error: fall back to the
+	// locally-computed checksum and leave checksum_ok true rather than failing the whole block.
+	expectedChecksum := computedChecksum[:]
+	checksumOK := true
+	if codeChecksumHex, err := tx.FindAttributeByKey(storeCodeEvent, attributeKeyCodeChecksum); err == nil {
+		decodedChecksum, err := hex.DecodeString(codeChecksumHex)
+		if err != nil {
+			return fmt.Errorf("error while decoding code checksum: %s", err)
+		}
+
+		expectedChecksum = decodedChecksum
+		checksumOK = bytes.Equal(computedChecksum[:], expectedChecksum)
+		if !checksumOK {
+			log.Error().Str("module", "wasm").Int64("code_id", codeID).Int64("height", tx.Height).
+				Msg("code checksum mismatch, persisting anyway with checksum_ok=false")
+		}
+	}
+
+	// Get instantiate contract event
+	instantiateEvent, err := tx.FindEventByType(index, wasmtypes.EventTypeInstantiate)
+	if err != nil {
+		return fmt.Errorf("error while searching for EventTypeInstantiate: %s", err)
+	}
+
+	contractAddress, err := tx.FindAttributeByKey(instantiateEvent, wasmtypes.AttributeKeyContractAddr)
+	if err != nil {
+		return fmt.Errorf("error while searching for AttributeKeyContractAddr: %s", err)
+	}
+
+	resultData, err := tx.FindAttributeByKey(instantiateEvent, wasmtypes.AttributeKeyResultDataHex)
+	if err != nil {
+		return fmt.Errorf("error while searching for AttributeKeyResultDataHex: %s", err)
+	}
+	resultDataBz, err := base64.StdEncoding.DecodeString(resultData)
+	if err != nil {
+		return fmt.Errorf("error while decoding result data: %s", err)
+	}
+
+	contractInfo, err := m.source.GetContractInfo(tx.Height, contractAddress)
+	if err != nil {
+		return fmt.Errorf("error while getting proposal: %s", err)
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, tx.Timestamp)
+	if err != nil {
+		return fmt.Errorf("error while parsing time: %s", err)
+	}
+
+	code := types.NewWasmCode(
+		msg.Sender, msg.WASMByteCode, msg.InstantiatePermission, uint64(codeID), "user",
+		expectedChecksum, len(msg.WASMByteCode), len(uncompressedWasmByteCode), checksumOK, tx.Height,
+	)
+	contract := types.NewWasmContract(msg.Sender, msg.Admin, uint64(codeID), msg.Label, msg.Msg, msg.Funds, contractAddress, string(resultDataBz), timestamp,
+		contractInfo.Creator, contractInfo.Extension, "user", tx.Height,
+	)
+
+	err = m.db.SaveWasmCodeBytes(types.NewWasmCodeBytes(expectedChecksum, uncompressedWasmByteCode))
+	if err != nil {
+		return fmt.Errorf("error while saving WasmCodeBytes: %s", err)
+	}
+
+	err = m.db.SaveWasmCodeAndContract(code, contract)
+	if err != nil {
+		return fmt.Errorf("error while saving WasmCode and WasmContract atomically: %s", err)
+	}
+
+	return m.HandleContractEvents(tx, index)
+}
@@ -0,0 +1,44 @@
+//go:build finschia
+// +build finschia
+
+package wasm
+
+import (
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestHandleMsgStoreCodeAndInstantiateContract_IgnoresOtherMsgTypes asserts that the registered
+// StoreCodeAndInstantiateHandler only claims a MsgStoreCodeAndInstantiateContract and leaves every
+// other message type for HandleMsg's regular switch to handle, without ever touching tx/m.db (which
+// are nil here and would panic if the handler tried to dereference them for an unmatched message).
+func TestHandleMsgStoreCodeAndInstantiateContract_IgnoresOtherMsgTypes(t *testing.T) {
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{
+			name: "a plain MsgStoreCode",
+			msg:  &wasmtypes.MsgStoreCode{},
+		},
+		{
+			name: "a plain MsgInstantiateContract",
+			msg:  &wasmtypes.MsgInstantiateContract{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			handled, err := handleMsgStoreCodeAndInstantiateContract(nil, 0, nil, tc.msg)
+			if handled {
+				t.Errorf("handleMsgStoreCodeAndInstantiateContract() handled = true, expected false")
+			}
+			if err != nil {
+				t.Errorf("handleMsgStoreCodeAndInstantiateContract() error = %s, expected nil", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/forbole/bdjuno/v3/types"
+	"github.com/rs/zerolog/log"
+)
+
+// RunAdditionalOperations implements modules.AdditionalOperationsModule. Each time it is called,
+// it takes a smart-query snapshot, at the latest height, of every contract listed in the wasm module's
+// contract-state-snapshot config -- e.g. a CW20 token_info, a CW721 num_tokens, or a DEX pool's
+// reserves -- and writes the decoded JSON result as a new point of the
+// wasm_contract_state_snapshot time series. This lets operators track any contract-defined metric
+// as a time series without writing a new module per dApp. ContractStateSnapshotConfig holds no
+// interval or block cadence: one snapshot per entry is taken per call, and nothing in this module
+// schedules further calls.
+func (m *Module) RunAdditionalOperations() error {
+	height, err := m.source.LatestHeight()
+	if err != nil {
+		return fmt.Errorf("error while getting latest height: %s", err)
+	}
+
+	limiter := newRateLimiter(m.contractStateSnapshotConfig.QueriesPerSecond)
+	for _, entry := range m.contractStateSnapshotConfig.Entries {
+		limiter.Wait()
+
+		if err := m.takeContractStateSnapshot(height, entry); err != nil {
+			log.Error().Str("module", "wasm").Str("contract_address", entry.ContractAddress).Str("label", entry.Label).
+				Err(err).Msg("error while taking contract state snapshot")
+		}
+	}
+
+	return nil
+}
+
+// takeContractStateSnapshot runs a single configured smart query and persists its result. A
+// failing query is recorded too (with its error message, no result), rather than returned, so
+// that one bad entry doesn't stop the rest of RunAdditionalOperations from running.
+func (m *Module) takeContractStateSnapshot(height int64, entry ContractStateSnapshotEntry) error {
+	result, err := m.source.QuerySmartContractState(height, entry.ContractAddress, []byte(entry.QueryMsg))
+	if err != nil {
+		return m.db.SaveWasmContractStateSnapshot(
+			types.NewWasmContractStateSnapshot(entry.ContractAddress, entry.Label, nil, err.Error(), height),
+		)
+	}
+
+	// Make sure the query actually returned valid JSON before persisting it
+	if !json.Valid(result) {
+		return fmt.Errorf("smart query for contract %s returned invalid JSON", entry.ContractAddress)
+	}
+
+	return m.db.SaveWasmContractStateSnapshot(
+		types.NewWasmContractStateSnapshot(entry.ContractAddress, entry.Label, result, "", height),
+	)
+}
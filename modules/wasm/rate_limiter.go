@@ -0,0 +1,34 @@
+package wasm
+
+import "time"
+
+// simpleRateLimiter throttles a sequence of calls to at most a fixed number per second, so a long
+// list of configured smart-query entries can't hammer the node back-to-back and stall the rest of
+// the indexer
+type simpleRateLimiter struct {
+	interval time.Duration
+	last     time.Time
+}
+
+// newRateLimiter builds a simpleRateLimiter allowing at most queriesPerSecond calls per second.
+// A value of 0 (or less) disables throttling entirely.
+func newRateLimiter(queriesPerSecond float64) *simpleRateLimiter {
+	if queriesPerSecond <= 0 {
+		return &simpleRateLimiter{}
+	}
+
+	return &simpleRateLimiter{interval: time.Duration(float64(time.Second) / queriesPerSecond)}
+}
+
+// Wait blocks, if necessary, until enough time has passed since the previous call to respect the
+// configured rate
+func (r *simpleRateLimiter) Wait() {
+	if r.interval == 0 {
+		return
+	}
+
+	if elapsed := time.Since(r.last); elapsed < r.interval {
+		time.Sleep(r.interval - elapsed)
+	}
+	r.last = time.Now()
+}
@@ -0,0 +1,50 @@
+package wasm
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiter_Disabled(t *testing.T) {
+	for _, queriesPerSecond := range []float64{0, -1} {
+		limiter := newRateLimiter(queriesPerSecond)
+		if limiter.interval != 0 {
+			t.Errorf("newRateLimiter(%v).interval = %v, expected 0", queriesPerSecond, limiter.interval)
+		}
+	}
+}
+
+func TestRateLimiter_Wait_DoesNotBlockOnFirstCall(t *testing.T) {
+	limiter := newRateLimiter(1)
+
+	start := time.Now()
+	limiter.Wait()
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("first Wait() call took %s, expected it to return immediately", elapsed)
+	}
+}
+
+func TestRateLimiter_Wait_ThrottlesSubsequentCalls(t *testing.T) {
+	// 20 queries per second => a 50ms minimum interval between calls
+	limiter := newRateLimiter(20)
+
+	start := time.Now()
+	limiter.Wait()
+	limiter.Wait()
+	elapsed := time.Since(start)
+
+	if elapsed < limiter.interval {
+		t.Errorf("two Wait() calls took %s, expected at least %s", elapsed, limiter.interval)
+	}
+}
+
+func TestRateLimiter_Wait_DisabledNeverBlocks(t *testing.T) {
+	limiter := newRateLimiter(0)
+
+	start := time.Now()
+	limiter.Wait()
+	limiter.Wait()
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("disabled limiter took %s, expected it to never block", elapsed)
+	}
+}
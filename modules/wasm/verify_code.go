@@ -0,0 +1,33 @@
+package wasm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"io"
+)
+
+// gzipMagic is the two leading bytes of every gzip stream
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// decompressWasmByteCode returns the decompressed wasm bytecode along with its sha256 checksum.
+// wasmd accepts both plain and gzip-compressed uploads, so wasmByteCode is returned unchanged
+// whenever it isn't gzipped.
+func decompressWasmByteCode(wasmByteCode []byte) ([]byte, [sha256.Size]byte, error) {
+	if !bytes.HasPrefix(wasmByteCode, gzipMagic) {
+		return wasmByteCode, sha256.Sum256(wasmByteCode), nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(wasmByteCode))
+	if err != nil {
+		return nil, [sha256.Size]byte{}, err
+	}
+	defer reader.Close()
+
+	uncompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, [sha256.Size]byte{}, err
+	}
+
+	return uncompressed, sha256.Sum256(uncompressed), nil
+}
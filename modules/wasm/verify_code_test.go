@@ -0,0 +1,63 @@
+package wasm
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"testing"
+)
+
+func TestDecompressWasmByteCode_Uncompressed(t *testing.T) {
+	wasmByteCode := []byte("\x00asm fake module bytes")
+
+	uncompressed, checksum, err := decompressWasmByteCode(wasmByteCode)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(uncompressed, wasmByteCode) {
+		t.Errorf("expected uncompressed bytecode to be returned unchanged, got %x", uncompressed)
+	}
+
+	expectedChecksum := sha256.Sum256(wasmByteCode)
+	if checksum != expectedChecksum {
+		t.Errorf("checksum = %x, expected %x", checksum, expectedChecksum)
+	}
+}
+
+func TestDecompressWasmByteCode_Gzipped(t *testing.T) {
+	original := []byte("\x00asm fake module bytes")
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(original); err != nil {
+		t.Fatalf("unexpected error writing gzip stream: %s", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("unexpected error closing gzip writer: %s", err)
+	}
+
+	uncompressed, checksum, err := decompressWasmByteCode(buf.Bytes())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(uncompressed, original) {
+		t.Errorf("uncompressed = %x, expected %x", uncompressed, original)
+	}
+
+	expectedChecksum := sha256.Sum256(original)
+	if checksum != expectedChecksum {
+		t.Errorf("checksum = %x, expected %x", checksum, expectedChecksum)
+	}
+}
+
+func TestDecompressWasmByteCode_InvalidGzip(t *testing.T) {
+	// Carries the gzip magic bytes but isn't a valid gzip stream afterwards
+	invalid := append([]byte{}, gzipMagic...)
+	invalid = append(invalid, 0x00, 0x01, 0x02)
+
+	if _, _, err := decompressWasmByteCode(invalid); err == nil {
+		t.Error("expected an error for a malformed gzip stream, got nil")
+	}
+}
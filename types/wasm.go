@@ -33,18 +33,37 @@ type WasmCode struct {
 	WasmByteCode          []byte
 	InstantiatePermission *wasmtypes.AccessConfig
 	CodeID                uint64
-	Height                int64
+	// Source tells whether the code was uploaded directly by a user ("user") or executed
+	// as the result of a passed gov proposal ("gov")
+	Source string
+	// Checksum is the sha256 of the decompressed wasm module, as reported by the chain's
+	// code_checksum event attribute, or computed locally when the chain doesn't emit one
+	Checksum []byte
+	// CompressedSize is len(WasmByteCode) as it was sent in the message
+	CompressedSize int
+	// UncompressedSize is the size of the wasm module once gzip-decoded (equal to CompressedSize
+	// when the upload wasn't gzipped)
+	UncompressedSize int
+	// ChecksumOK tells whether the sha256 of the decompressed bytecode matched Checksum
+	ChecksumOK bool
+	Height     int64
 }
 
 // NewWasmCode allows to build a new x/wasm code instance
 func NewWasmCode(
-	sender string, wasmByteCode []byte, initPermission *wasmtypes.AccessConfig, codeID uint64, height int64,
+	sender string, wasmByteCode []byte, initPermission *wasmtypes.AccessConfig, codeID uint64, source string,
+	checksum []byte, compressedSize int, uncompressedSize int, checksumOK bool, height int64,
 ) WasmCode {
 	return WasmCode{
 		Sender:                sender,
 		WasmByteCode:          wasmByteCode,
 		InstantiatePermission: initPermission,
 		CodeID:                codeID,
+		Source:                source,
+		Checksum:              checksum,
+		CompressedSize:        compressedSize,
+		UncompressedSize:      uncompressedSize,
+		ChecksumOK:            checksumOK,
 		Height:                height,
 	}
 }
@@ -62,13 +81,16 @@ type WasmContract struct {
 	Data                  string
 	InstantiatedAt        time.Time
 	ContractInfoExtension string
-	Height                int64
+	// Source tells whether the contract was instantiated directly by a user ("user") or as
+	// the result of a passed gov proposal ("gov")
+	Source string
+	Height int64
 }
 
 // NewWasmCode allows to build a new x/wasm contract instance
 func NewWasmContract(
 	sender string, admin string, codeID uint64, label string, rawMsg wasmtypes.RawContractMessage, funds sdk.Coins, contractAddress string, data string,
-	instantiatedAt time.Time, creator string, contractInfoExtension string, height int64,
+	instantiatedAt time.Time, creator string, contractInfoExtension string, source string, height int64,
 ) WasmContract {
 	rawContractMsg, _ := rawMsg.MarshalJSON()
 
@@ -84,6 +106,7 @@ func NewWasmContract(
 		Data:                  data,
 		InstantiatedAt:        instantiatedAt,
 		ContractInfoExtension: contractInfoExtension,
+		Source:                source,
 		Height:                height,
 	}
 }
@@ -115,4 +138,32 @@ func NewWasmExecuteContract(
 		ExecutedAt:      executedAt,
 		Height:          height,
 	}
+}
+
+// WasmSudoContract represents a privileged sudo call made against a CosmWasm contract, bypassing
+// the usual permission checks that apply to MsgExecuteContract
+type WasmSudoContract struct {
+	Sender          string
+	ContractAddress string
+	RawContractMsg  []byte
+	Data            string
+	ExecutedAt      time.Time
+	Height          int64
+}
+
+// NewWasmSudoContract allows to build a new x/wasm sudo contract instance
+func NewWasmSudoContract(
+	sender string, contractAddress string, rawMsg wasmtypes.RawContractMessage,
+	data string, executedAt time.Time, height int64,
+) WasmSudoContract {
+	rawContractMsg, _ := rawMsg.MarshalJSON()
+
+	return WasmSudoContract{
+		Sender:          sender,
+		ContractAddress: contractAddress,
+		RawContractMsg:  rawContractMsg,
+		Data:            data,
+		ExecutedAt:      executedAt,
+		Height:          height,
+	}
 }
\ No newline at end of file
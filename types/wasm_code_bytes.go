@@ -0,0 +1,17 @@
+package types
+
+// WasmCodeBytes represents the decompressed bytecode of a wasm module, stored once per checksum
+// and referenced by every WasmCode row sharing that checksum, so that uploading the same contract
+// code under multiple code IDs doesn't duplicate the (often large) bytecode on disk
+type WasmCodeBytes struct {
+	Checksum     []byte
+	WasmByteCode []byte
+}
+
+// NewWasmCodeBytes allows to build a new x/wasm code bytes instance
+func NewWasmCodeBytes(checksum []byte, wasmByteCode []byte) WasmCodeBytes {
+	return WasmCodeBytes{
+		Checksum:     checksum,
+		WasmByteCode: wasmByteCode,
+	}
+}
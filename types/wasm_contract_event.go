@@ -0,0 +1,29 @@
+package types
+
+// WasmContractEvent represents a single custom event (type "wasm" or prefixed "wasm-") emitted by
+// a CosmWasm contract during the execution of a message, together with all of its attributes
+type WasmContractEvent struct {
+	Height          int64
+	TxHash          string
+	MsgIndex        int
+	EventIndex      int
+	ContractAddress string
+	EventType       string
+	Attributes      map[string]string
+}
+
+// NewWasmContractEvent allows to build a new x/wasm contract event instance
+func NewWasmContractEvent(
+	height int64, txHash string, msgIndex int, eventIndex int,
+	contractAddress string, eventType string, attributes map[string]string,
+) WasmContractEvent {
+	return WasmContractEvent{
+		Height:          height,
+		TxHash:          txHash,
+		MsgIndex:        msgIndex,
+		EventIndex:      eventIndex,
+		ContractAddress: contractAddress,
+		EventType:       eventType,
+		Attributes:      attributes,
+	}
+}
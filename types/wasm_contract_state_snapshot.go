@@ -0,0 +1,25 @@
+package types
+
+// WasmContractStateSnapshot represents a single point of a smart-query driven time series taken
+// against a CosmWasm contract, e.g. a CW20 token_info, a CW721 num_tokens, or a DEX pool's
+// reserves
+type WasmContractStateSnapshot struct {
+	ContractAddress string
+	Label           string
+	Result          []byte
+	Error           string
+	Height          int64
+}
+
+// NewWasmContractStateSnapshot allows to build a new x/wasm contract state snapshot instance. A
+// failed query is represented by passing a nil result and a non-empty error, so that operators can
+// tell a genuinely empty contract response apart from a failing one.
+func NewWasmContractStateSnapshot(contractAddress string, label string, result []byte, queryErr string, height int64) WasmContractStateSnapshot {
+	return WasmContractStateSnapshot{
+		ContractAddress: contractAddress,
+		Label:           label,
+		Result:          result,
+		Error:           queryErr,
+		Height:          height,
+	}
+}
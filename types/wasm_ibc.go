@@ -0,0 +1,65 @@
+package types
+
+// WasmContractIBCChannel represents the lifecycle state of an IBC channel owned by a CosmWasm
+// contract port (a port whose ID is "wasm.<contract_address>")
+type WasmContractIBCChannel struct {
+	ContractAddress       string
+	PortID                string
+	ChannelID             string
+	ConnectionID          string
+	CounterpartyPortID    string
+	CounterpartyChannelID string
+	State                 string
+	Height                int64
+}
+
+// NewWasmContractIBCChannel allows to build a new x/wasm contract IBC channel instance
+func NewWasmContractIBCChannel(
+	contractAddress string, portID string, channelID string, connectionID string,
+	counterpartyPortID string, counterpartyChannelID string, state string, height int64,
+) WasmContractIBCChannel {
+	return WasmContractIBCChannel{
+		ContractAddress:       contractAddress,
+		PortID:                portID,
+		ChannelID:             channelID,
+		ConnectionID:          connectionID,
+		CounterpartyPortID:    counterpartyPortID,
+		CounterpartyChannelID: counterpartyChannelID,
+		State:                 state,
+		Height:                height,
+	}
+}
+
+// WasmContractIBCPacket represents a single IBC packet sent, received, acknowledged or timed out
+// on a channel owned by a CosmWasm contract port
+type WasmContractIBCPacket struct {
+	ContractAddress string
+	ChannelID       string
+	PortID          string
+	Sequence        uint64
+	Direction       string
+	PacketData      []byte
+	Ack             []byte
+	TimeoutHeight   string
+	Height          int64
+	TxHash          string
+}
+
+// NewWasmContractIBCPacket allows to build a new x/wasm contract IBC packet instance
+func NewWasmContractIBCPacket(
+	contractAddress string, channelID string, portID string, sequence uint64, direction string,
+	packetData []byte, ack []byte, timeoutHeight string, height int64, txHash string,
+) WasmContractIBCPacket {
+	return WasmContractIBCPacket{
+		ContractAddress: contractAddress,
+		ChannelID:       channelID,
+		PortID:          portID,
+		Sequence:        sequence,
+		Direction:       direction,
+		PacketData:      packetData,
+		Ack:             ack,
+		TimeoutHeight:   timeoutHeight,
+		Height:          height,
+		TxHash:          txHash,
+	}
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// WasmStoreCodeProposal represents a gov StoreCodeProposal targeting the x/wasm module
+type WasmStoreCodeProposal struct {
+	ProposalID            uint64
+	RunAs                 string
+	WasmByteCode          []byte
+	InstantiatePermission *wasmtypes.AccessConfig
+	Height                int64
+}
+
+// NewWasmStoreCodeProposal allows to build a new x/wasm store code proposal instance
+func NewWasmStoreCodeProposal(proposalID uint64, runAs string, wasmByteCode []byte, initPermission *wasmtypes.AccessConfig, height int64) WasmStoreCodeProposal {
+	return WasmStoreCodeProposal{
+		ProposalID:            proposalID,
+		RunAs:                 runAs,
+		WasmByteCode:          wasmByteCode,
+		InstantiatePermission: initPermission,
+		Height:                height,
+	}
+}
+
+// WasmInstantiateContractProposal represents a gov InstantiateContractProposal targeting the x/wasm module
+type WasmInstantiateContractProposal struct {
+	ProposalID     uint64
+	RunAs          string
+	Admin          string
+	CodeID         uint64
+	Label          string
+	RawContractMsg wasmtypes.RawContractMessage
+	Funds          string
+	Height         int64
+}
+
+// NewWasmInstantiateContractProposal allows to build a new x/wasm instantiate contract proposal instance
+func NewWasmInstantiateContractProposal(
+	proposalID uint64, runAs string, admin string, codeID uint64, label string,
+	rawMsg wasmtypes.RawContractMessage, funds string, height int64,
+) WasmInstantiateContractProposal {
+	return WasmInstantiateContractProposal{
+		ProposalID:     proposalID,
+		RunAs:          runAs,
+		Admin:          admin,
+		CodeID:         codeID,
+		Label:          label,
+		RawContractMsg: rawMsg,
+		Funds:          funds,
+		Height:         height,
+	}
+}
+
+// WasmMigrateContractProposal represents a gov MigrateContractProposal targeting the x/wasm module
+type WasmMigrateContractProposal struct {
+	ProposalID      uint64
+	ContractAddress string
+	CodeID          uint64
+	RawContractMsg  wasmtypes.RawContractMessage
+	Height          int64
+}
+
+// NewWasmMigrateContractProposal allows to build a new x/wasm migrate contract proposal instance
+func NewWasmMigrateContractProposal(
+	proposalID uint64, contractAddress string, codeID uint64, rawMsg wasmtypes.RawContractMessage, height int64,
+) WasmMigrateContractProposal {
+	return WasmMigrateContractProposal{
+		ProposalID:      proposalID,
+		ContractAddress: contractAddress,
+		CodeID:          codeID,
+		RawContractMsg:  rawMsg,
+		Height:          height,
+	}
+}
+
+// WasmExecuteContractProposal represents a gov ExecuteContractProposal targeting the x/wasm module
+type WasmExecuteContractProposal struct {
+	ProposalID      uint64
+	RunAs           string
+	ContractAddress string
+	RawContractMsg  wasmtypes.RawContractMessage
+	Funds           string
+	Height          int64
+}
+
+// NewWasmExecuteContractProposal allows to build a new x/wasm execute contract proposal instance
+func NewWasmExecuteContractProposal(
+	proposalID uint64, runAs string, contractAddress string, rawMsg wasmtypes.RawContractMessage, funds string, height int64,
+) WasmExecuteContractProposal {
+	return WasmExecuteContractProposal{
+		ProposalID:      proposalID,
+		RunAs:           runAs,
+		ContractAddress: contractAddress,
+		RawContractMsg:  rawMsg,
+		Funds:           funds,
+		Height:          height,
+	}
+}
+
+// WasmSudoContractProposal represents a gov SudoContractProposal targeting the x/wasm module
+type WasmSudoContractProposal struct {
+	ProposalID      uint64
+	ContractAddress string
+	RawContractMsg  wasmtypes.RawContractMessage
+	Height          int64
+}
+
+// NewWasmSudoContractProposal allows to build a new x/wasm sudo contract proposal instance
+func NewWasmSudoContractProposal(
+	proposalID uint64, contractAddress string, rawMsg wasmtypes.RawContractMessage, height int64,
+) WasmSudoContractProposal {
+	return WasmSudoContractProposal{
+		ProposalID:      proposalID,
+		ContractAddress: contractAddress,
+		RawContractMsg:  rawMsg,
+		Height:          height,
+	}
+}
+
+// WasmUpdateAdminProposal represents a gov UpdateAdminProposal targeting the x/wasm module
+type WasmUpdateAdminProposal struct {
+	ProposalID      uint64
+	NewAdmin        string
+	ContractAddress string
+	Height          int64
+}
+
+// NewWasmUpdateAdminProposal allows to build a new x/wasm update admin proposal instance
+func NewWasmUpdateAdminProposal(proposalID uint64, newAdmin string, contractAddress string, height int64) WasmUpdateAdminProposal {
+	return WasmUpdateAdminProposal{
+		ProposalID:      proposalID,
+		NewAdmin:        newAdmin,
+		ContractAddress: contractAddress,
+		Height:          height,
+	}
+}
+
+// WasmClearAdminProposal represents a gov ClearAdminProposal targeting the x/wasm module
+type WasmClearAdminProposal struct {
+	ProposalID      uint64
+	ContractAddress string
+	Height          int64
+}
+
+// NewWasmClearAdminProposal allows to build a new x/wasm clear admin proposal instance
+func NewWasmClearAdminProposal(proposalID uint64, contractAddress string, height int64) WasmClearAdminProposal {
+	return WasmClearAdminProposal{
+		ProposalID:      proposalID,
+		ContractAddress: contractAddress,
+		Height:          height,
+	}
+}
+
+// WasmPinCodesProposal represents a gov PinCodesProposal targeting the x/wasm module
+type WasmPinCodesProposal struct {
+	ProposalID uint64
+	CodeIDs    []uint64
+	Height     int64
+}
+
+// NewWasmPinCodesProposal allows to build a new x/wasm pin codes proposal instance
+func NewWasmPinCodesProposal(proposalID uint64, codeIDs []uint64, height int64) WasmPinCodesProposal {
+	return WasmPinCodesProposal{
+		ProposalID: proposalID,
+		CodeIDs:    codeIDs,
+		Height:     height,
+	}
+}
+
+// WasmUnpinCodesProposal represents a gov UnpinCodesProposal targeting the x/wasm module
+type WasmUnpinCodesProposal struct {
+	ProposalID uint64
+	CodeIDs    []uint64
+	Height     int64
+}
+
+// NewWasmUnpinCodesProposal allows to build a new x/wasm unpin codes proposal instance
+func NewWasmUnpinCodesProposal(proposalID uint64, codeIDs []uint64, height int64) WasmUnpinCodesProposal {
+	return WasmUnpinCodesProposal{
+		ProposalID: proposalID,
+		CodeIDs:    codeIDs,
+		Height:     height,
+	}
+}